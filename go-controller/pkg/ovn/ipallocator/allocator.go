@@ -69,6 +69,9 @@ func (e *ErrNotInRange) Error() string {
 //   r.base                     r.base + r.max
 //     |                              |
 //   offset #0 of r.allocated   last offset of r.allocated
+//
+// For /31 and /127 point-to-point CIDRs both addresses are usable, so r.base
+// is the CIDR base IP and r.max is 2.
 type Range struct {
 	net *net.IPNet
 	// base is a cached version of the start IP in the CIDR range as a *big.Int
@@ -85,19 +88,26 @@ func NewAllocatorCIDRRange(cidr *net.IPNet, allocatorFactory allocator.Allocator
 	base := utilnet.BigForIP(cidr.IP)
 	rangeSpec := cidr.String()
 
+	// Point-to-point /31 and /127 networks have no network or broadcast
+	// address (RFC 3021 and RFC 6164), so both of their addresses are usable.
+	ones, bits := cidr.Mask.Size()
+	pointToPoint := bits-ones == 1
+
 	if utilnet.IsIPv6CIDR(cidr) {
 		// Limit the max size, since the allocator keeps a bitmap of that size.
 		if max > 65536 {
 			max = 65536
 		}
-	} else {
+	} else if !pointToPoint {
 		// Don't use the IPv4 network's broadcast address.
 		max--
 	}
 
-	// Don't use the network's ".0" address.
-	base.Add(base, big.NewInt(1))
-	max--
+	if !pointToPoint {
+		// Don't use the network's ".0" address.
+		base.Add(base, big.NewInt(1))
+		max--
+	}
 
 	r := Range{
 		net:  cidr,
@@ -186,8 +196,7 @@ func (r *Range) Release(ip net.IP) error {
 // ForEach calls the provided function for each allocated IP.
 func (r *Range) ForEach(fn func(net.IP)) {
 	r.alloc.ForEach(func(offset int) {
-		ip, _ := utilnet.GetIndexedIP(r.net, offset+1) // +1 because Range doesn't store IP 0
-		fn(ip)
+		fn(utilnet.AddIPOffset(r.base, offset))
 	})
 }
 
@@ -202,8 +211,36 @@ func (r *Range) Has(ip net.IP) bool {
 	return r.alloc.Has(offset)
 }
 
+// Snapshot saves the current state of the pool. It returns the range
+// specifier the state applies to along with the allocation data.
+func (r *Range) Snapshot() (string, []byte, error) {
+	snapshottable, ok := r.alloc.(allocator.Snapshottable)
+	if !ok {
+		return "", nil, fmt.Errorf("not a snapshottable allocator")
+	}
+	rangeSpec, data := snapshottable.Snapshot()
+	return rangeSpec, data, nil
+}
+
+// Restore restores the pool to the previously captured state. ErrMismatchedNetwork
+// is returned if the provided IPNet range doesn't exactly match the previous range.
+func (r *Range) Restore(net *net.IPNet, data []byte) error {
+	if !net.IP.Equal(r.net.IP) || net.Mask.String() != r.net.Mask.String() {
+		return ErrMismatchedNetwork
+	}
+	snapshottable, ok := r.alloc.(allocator.Snapshottable)
+	if !ok {
+		return fmt.Errorf("not a snapshottable allocator")
+	}
+	if err := snapshottable.Restore(net.String(), data); err != nil {
+		return fmt.Errorf("restoring snapshot encountered %v", err)
+	}
+	return nil
+}
+
 // contains returns true and the offset if the ip is in the range, and false
-// and nil otherwise. The first and last addresses of the CIDR are omitted.
+// and nil otherwise. The first and last addresses of the CIDR are omitted,
+// except for point-to-point CIDRs.
 func (r *Range) contains(ip net.IP) (bool, int) {
 	if !r.net.Contains(ip) {
 		return false, 0
@@ -223,6 +223,64 @@ func TestAllocateSmall(t *testing.T) {
 	t.Logf("allocated: %v", found)
 }
 
+func TestAllocatePointToPoint(t *testing.T) {
+	testCases := []struct {
+		name string
+		cidr string
+		ips  []string
+	}{
+		{
+			name: "IPv4 /31",
+			cidr: "192.168.1.0/31",
+			ips:  []string{"192.168.1.0", "192.168.1.1"},
+		},
+		{
+			name: "IPv6 /127",
+			cidr: "2001:db8:1::/127",
+			ips:  []string{"2001:db8:1::", "2001:db8:1::1"},
+		},
+	}
+	for _, tc := range testCases {
+		_, cidr, err := net.ParseCIDR(tc.cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := NewCIDRRange(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f := r.Free(); f != 2 {
+			t.Errorf("Test %s unexpected free %d", tc.name, f)
+		}
+		for _, ipStr := range tc.ips {
+			if err := r.Allocate(net.ParseIP(ipStr)); err != nil {
+				t.Errorf("Test %s error allocating %s: %v", tc.name, ipStr, err)
+			}
+		}
+		if _, err := r.AllocateNext(); err != ErrFull {
+			t.Errorf("Test %s expected full range, got %v", tc.name, err)
+		}
+		calls := sets.NewString()
+		r.ForEach(func(ip net.IP) {
+			calls.Insert(ip.String())
+		})
+		if !calls.Equal(sets.NewString(tc.ips...)) {
+			t.Errorf("Test %s expected ForEach to return %v, got %v", tc.name, tc.ips, calls.List())
+		}
+		released := net.ParseIP(tc.ips[0])
+		if err := r.Release(released); err != nil {
+			t.Fatal(err)
+		}
+		ip, err := r.AllocateNext()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !released.Equal(ip) {
+			t.Errorf("Test %s unexpected %s : %s", tc.name, ip, released)
+		}
+	}
+}
+
 func TestForEach(t *testing.T) {
 	_, cidr, err := net.ParseCIDR("192.168.1.0/24")
 	if err != nil {
@@ -262,3 +320,60 @@ func TestForEach(t *testing.T) {
 		}
 	}
 }
+
+func TestSnapshot(t *testing.T) {
+	_, cidr, err := net.ParseCIDR("192.168.1.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewCIDRRange(cidr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ip := []net.IP{}
+	for i := 0; i < 10; i++ {
+		n, err := r.AllocateNext()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ip = append(ip, n)
+	}
+
+	rangeSpec, data, err := r.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, network, err := net.ParseCIDR(rangeSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !network.IP.Equal(cidr.IP) || network.Mask.String() != cidr.Mask.String() {
+		t.Fatalf("mismatched networks: %s : %s", network, cidr)
+	}
+
+	_, otherCidr, err := net.ParseCIDR("192.168.2.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Restore(otherCidr, data); err != ErrMismatchedNetwork {
+		t.Fatal(err)
+	}
+	other, err := NewCIDRRange(network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Restore(network, data); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range ip {
+		if !other.Has(n) {
+			t.Errorf("restored range does not have %s", n)
+		}
+	}
+	if other.Free() != r.Free() {
+		t.Errorf("counts do not match: %d", other.Free())
+	}
+}
@@ -207,6 +207,13 @@ func (manager *logicalSwitchManager) AllocateIPs(nodeName string, ipnets []*net.
 // AllocateNextIPs allocates IP addresses from each of the host subnets
 // for a given switch
 func (manager *logicalSwitchManager) AllocateNextIPs(nodeName string) ([]*net.IPNet, error) {
+	return manager.AllocateNextNIPs(nodeName, 1)
+}
+
+// AllocateNextNIPs allocates count IP addresses from each of the host subnets
+// for a given switch. The IPs are returned grouped by host subnet, in the order
+// of the host subnets. Either all of them are allocated or none are.
+func (manager *logicalSwitchManager) AllocateNextNIPs(nodeName string, count int) ([]*net.IPNet, error) {
 	manager.RLock()
 	defer manager.RUnlock()
 	var ipnets []*net.IPNet
@@ -218,6 +225,10 @@ func (manager *logicalSwitchManager) AllocateNextIPs(nodeName string) ([]*net.IP
 		return nil, fmt.Errorf("node %s not found in the logical switch manager cache", nodeName)
 	}
 
+	if count < 1 {
+		return nil, fmt.Errorf("failed to allocate IPs for node %s because the count %d is not positive", nodeName, count)
+	}
+
 	if len(lsi.ipams) == 0 {
 		return nil, fmt.Errorf("failed to allocate IPs for node %s because there is no IPAM instance", nodeName)
 	}
@@ -232,7 +243,7 @@ func (manager *logicalSwitchManager) AllocateNextIPs(nodeName string) ([]*net.IP
 			// iterate over range of already allocated indices and release
 			// ips allocated before the error occurred.
 			for relIdx, relIPNet := range ipnets {
-				if relErr := lsi.ipams[relIdx].Release(relIPNet.IP); relErr != nil {
+				if relErr := lsi.ipams[relIdx/count].Release(relIPNet.IP); relErr != nil {
 					klog.Errorf("Error while releasing IP: %s, err: %v", relIPNet.IP, relErr)
 				}
 			}
@@ -241,15 +252,17 @@ func (manager *logicalSwitchManager) AllocateNextIPs(nodeName string) ([]*net.IP
 	}()
 
 	for idx, ipam := range lsi.ipams {
-		ip, err = ipam.AllocateNext()
-		if err != nil {
-			return nil, err
-		}
-		ipnet := &net.IPNet{
-			IP:   ip,
-			Mask: lsi.hostSubnets[idx].Mask,
+		for i := 0; i < count; i++ {
+			ip, err = ipam.AllocateNext()
+			if err != nil {
+				return nil, err
+			}
+			ipnet := &net.IPNet{
+				IP:   ip,
+				Mask: lsi.hostSubnets[idx].Mask,
+			}
+			ipnets = append(ipnets, ipnet)
 		}
-		ipnets = append(ipnets, ipnet)
 	}
 	return ipnets, nil
 }
@@ -174,6 +174,38 @@ var _ = ginkgo.Describe("OVN Logical Switch Manager operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("IPAM for each subnet allocates several IPs at once", func() {
+			app.Action = func(ctx *cli.Context) error {
+				_, err := config.InitConfig(ctx, fexec, nil)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				testNode := testNodeSubnetData{
+					nodeName: "testNode1",
+					subnets: []string{
+						"10.1.1.0/24",
+						"2000::/64",
+					},
+				}
+
+				expectedIPAllocations := [][]string{
+					{"10.1.1.3/24", "10.1.1.4/24", "2000::3/64", "2000::4/64"},
+					{"10.1.1.5/24", "10.1.1.6/24", "2000::5/64", "2000::6/64"},
+				}
+
+				err = lsManager.AddNode(testNode.nodeName, ovntest.MustParseIPNets(testNode.subnets...))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				for _, expectedIPs := range expectedIPAllocations {
+					ips, err := lsManager.AllocateNextNIPs(testNode.nodeName, 2)
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Expect(ips).To(gomega.Equal(ovntest.MustParseIPNets(expectedIPs...)))
+				}
+				_, err = lsManager.AllocateNextNIPs(testNode.nodeName, 0)
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("IPAM allocates, releases, and reallocates IPs correctly", func() {
 			app.Action = func(ctx *cli.Context) error {
 				_, err := config.InitConfig(ctx, fexec, nil)
@@ -247,6 +279,36 @@ var _ = ginkgo.Describe("OVN Logical Switch Manager operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("releases all the IPs allocated at once when any of them can't be allocated", func() {
+			app.Action = func(ctx *cli.Context) error {
+				_, err := config.InitConfig(ctx, fexec, nil)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				testNode := testNodeSubnetData{
+					nodeName: "testNode1",
+					subnets: []string{
+						"10.1.1.0/24",
+						"10.1.2.0/29",
+					},
+				}
+
+				err = lsManager.AddNode(testNode.nodeName, ovntest.MustParseIPNets(testNode.subnets...))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				// the second subnet only has 4 valid ips left
+				ips, err := lsManager.AllocateNextNIPs(testNode.nodeName, 5)
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				gomega.Expect(len(ips)).To(gomega.Equal(0))
+				// all of them are still available after the failed allocation
+				ips, err = lsManager.AllocateNextNIPs(testNode.nodeName, 4)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(len(ips)).To(gomega.Equal(8))
+				gomega.Expect(ips[4:]).To(gomega.ConsistOf(ovntest.MustParseIPNets(
+					"10.1.2.3/29", "10.1.2.4/29", "10.1.2.5/29", "10.1.2.6/29")))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("fails correctly when trying to block a previously allocated IP", func() {
 			app.Action = func(ctx *cli.Context) error {
 				_, err := config.InitConfig(ctx, fexec, nil)
@@ -208,13 +208,30 @@ type Controller struct {
 	// Map of pods that need to be retried, and the timestamp of when they last failed
 	retryPods     map[types.UID]retryEntry
 	retryPodsLock sync.Mutex
+	// Map of pods currently being retried outside of retryPodsLock; the channel
+	// is closed once the retry is done
+	retryPodsInFlight map[types.UID]chan struct{}
 }
 
 type retryEntry struct {
 	pod       *kapi.Pod
 	timeStamp time.Time
+	// backoff is how long to wait after timeStamp before retrying the pod
+	backoff time.Duration
 }
 
+const (
+	// retryPodsInterval is how often the retryPods map is checked for pods
+	// whose backoff has expired
+	retryPodsInterval = time.Second
+	// retryPodInitialBackoff is the delay before the first retry of a failed pod
+	retryPodInitialBackoff = time.Second
+	// retryPodMaxBackoff caps the exponential backoff between pod retries
+	retryPodMaxBackoff = time.Minute
+	// retryPodBackoffJitter spreads out the retries of pods that failed together
+	retryPodBackoffJitter = 0.1
+)
+
 const (
 	// TCP is the constant string for the string "TCP"
 	TCP = "TCP"
@@ -284,6 +301,7 @@ func NewOvnController(ovnClient *util.OVNClientset, wf *factory.WatchFactory,
 		serviceLBLock:            sync.Mutex{},
 		joinSwIPManager:          nil,
 		retryPods:                make(map[types.UID]retryEntry),
+		retryPodsInFlight:        make(map[types.UID]chan struct{}),
 		recorder:                 recorder,
 		ovnNBClient:              ovnNBClient,
 		ovnSBClient:              ovnSBClient,
@@ -448,46 +466,85 @@ func (oc *Controller) recordPodEvent(addErr error, pod *kapi.Pod) {
 	}
 }
 
-// iterateRetryPods checks if any outstanding pods have been waiting for 60 seconds of last known failure
-// then tries to re-add them if so
+// nextRetryBackoff returns the backoff to use after a retry that waited
+// backoff has failed again: it doubles up to retryPodMaxBackoff, plus up to
+// retryPodBackoffJitter of jitter
+func nextRetryBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > retryPodMaxBackoff {
+		backoff = retryPodMaxBackoff
+	}
+	return utilwait.Jitter(backoff, retryPodBackoffJitter)
+}
+
+// iterateRetryPods checks if any outstanding pods have been waiting for their
+// backoff since the last known failure then tries to re-add them if so. The
+// pods are retried without holding retryPodsLock, so that pod event handlers
+// only wait on the retry of the pod they are handling.
 func (oc *Controller) iterateRetryPods() {
 	oc.retryPodsLock.Lock()
-	defer oc.retryPodsLock.Unlock()
 	now := time.Now()
+	duePods := []retryEntry{}
 	for uid, podEntry := range oc.retryPods {
+		if _, ok := oc.retryPodsInFlight[uid]; ok {
+			continue
+		}
+		if now.After(podEntry.timeStamp.Add(podEntry.backoff)) {
+			oc.retryPodsInFlight[uid] = make(chan struct{})
+			duePods = append(duePods, podEntry)
+		}
+	}
+	oc.retryPodsLock.Unlock()
+
+	for _, podEntry := range duePods {
 		pod := podEntry.pod
-		podTimer := podEntry.timeStamp.Add(time.Minute)
-		if now.After(podTimer) {
-			podDesc := fmt.Sprintf("[%s/%s/%s]", pod.UID, pod.Namespace, pod.Name)
-			klog.Infof("%s retry pod setup", podDesc)
-
-			if oc.ensurePod(nil, pod, true) {
-				klog.Infof("%s pod setup successful", podDesc)
-				delete(oc.retryPods, uid)
-			} else {
-				klog.Infof("%s setup retry failed; will try again later", podDesc)
-				oc.retryPods[uid] = retryEntry{pod, time.Now()}
-			}
+		podDesc := fmt.Sprintf("[%s/%s/%s]", pod.UID, pod.Namespace, pod.Name)
+		klog.V(5).Infof("%s retry pod setup", podDesc)
+
+		success := oc.ensurePod(nil, pod, true)
+
+		oc.retryPodsLock.Lock()
+		if success {
+			klog.Infof("%s pod setup successful", podDesc)
+			delete(oc.retryPods, pod.UID)
+		} else if _, ok := oc.retryPods[pod.UID]; ok {
+			backoff := nextRetryBackoff(podEntry.backoff)
+			klog.Infof("%s setup retry failed; will try again in %v", podDesc, backoff)
+			oc.retryPods[pod.UID] = retryEntry{pod, time.Now(), backoff}
 		}
+		close(oc.retryPodsInFlight[pod.UID])
+		delete(oc.retryPodsInFlight, pod.UID)
+		oc.retryPodsLock.Unlock()
 	}
 }
 
 // checkAndDeleteRetryPod deletes a specific entry from the map, if it existed, returns true
-func (oc *Controller) checkAndDeleteRetryPod(uid types.UID) bool {
+// along with the backoff the pod was waiting on. If there was no entry the initial backoff
+// is returned. If the pod is being retried, it first waits for that retry to finish.
+func (oc *Controller) checkAndDeleteRetryPod(uid types.UID) (time.Duration, bool) {
 	oc.retryPodsLock.Lock()
 	defer oc.retryPodsLock.Unlock()
-	if _, ok := oc.retryPods[uid]; ok {
+	for {
+		inFlight, ok := oc.retryPodsInFlight[uid]
+		if !ok {
+			break
+		}
+		oc.retryPodsLock.Unlock()
+		<-inFlight
+		oc.retryPodsLock.Lock()
+	}
+	if podEntry, ok := oc.retryPods[uid]; ok {
 		delete(oc.retryPods, uid)
-		return true
+		return podEntry.backoff, true
 	}
-	return false
+	return retryPodInitialBackoff, false
 }
 
-// addRetryPod tracks a failed pod to retry later
-func (oc *Controller) addRetryPod(pod *kapi.Pod) {
+// addRetryPod tracks a failed pod to retry later, after the given backoff
+func (oc *Controller) addRetryPod(pod *kapi.Pod, backoff time.Duration) {
 	oc.retryPodsLock.Lock()
 	defer oc.retryPodsLock.Unlock()
-	oc.retryPods[pod.UID] = retryEntry{pod, time.Now()}
+	oc.retryPods[pod.UID] = retryEntry{pod, time.Now(), backoff}
 }
 
 func exGatewayAnnotationsChanged(oldPod, newPod *kapi.Pod) bool {
@@ -534,8 +591,8 @@ func (oc *Controller) ensurePod(oldPod, pod *kapi.Pod, addPort bool) bool {
 // WatchPods starts the watching of Pod resource and calls back the appropriate handler logic
 func (oc *Controller) WatchPods() {
 	go func() {
-		// track the retryPods map and every 30 seconds check if any pods need to be retried
-		utilwait.Until(oc.iterateRetryPods, 30*time.Second, oc.stopChan)
+		// track the retryPods map and periodically check if any pods need to be retried
+		utilwait.Until(oc.iterateRetryPods, retryPodsInterval, oc.stopChan)
 	}()
 
 	start := time.Now()
@@ -543,15 +600,17 @@ func (oc *Controller) WatchPods() {
 		AddFunc: func(obj interface{}) {
 			pod := obj.(*kapi.Pod)
 			if !oc.ensurePod(nil, pod, true) {
-				oc.addRetryPod(pod)
+				oc.addRetryPod(pod, retryPodInitialBackoff)
 			}
 		},
 		UpdateFunc: func(old, newer interface{}) {
 			oldPod := old.(*kapi.Pod)
 			pod := newer.(*kapi.Pod)
-			if !oc.ensurePod(oldPod, pod, oc.checkAndDeleteRetryPod(pod.UID)) {
-				// add back the failed pod
-				oc.addRetryPod(pod)
+			backoff, retry := oc.checkAndDeleteRetryPod(pod.UID)
+			if !oc.ensurePod(oldPod, pod, retry) {
+				// add back the failed pod, keeping the backoff it has built up
+				// so that frequent pod updates don't reset it
+				oc.addRetryPod(pod, backoff)
 				return
 			}
 		},
@@ -0,0 +1,39 @@
+package ovn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextRetryBackoff(t *testing.T) {
+	testcases := []struct {
+		desc     string
+		backoff  time.Duration
+		expected time.Duration
+	}{
+		{
+			desc:     "initial backoff doubles",
+			backoff:  time.Second,
+			expected: 2 * time.Second,
+		},
+		{
+			desc:     "backoff is capped at the maximum",
+			backoff:  32 * time.Second,
+			expected: time.Minute,
+		},
+		{
+			desc:     "maximum backoff stays at the maximum",
+			backoff:  time.Minute,
+			expected: time.Minute,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			backoff := nextRetryBackoff(tc.backoff)
+			assert.GreaterOrEqual(t, int64(backoff), int64(tc.expected))
+			assert.Less(t, int64(backoff), int64(float64(tc.expected)*(1+retryPodBackoffJitter)))
+		})
+	}
+}
@@ -29,6 +29,26 @@ func getPodAnnotations(fakeClient kubernetes.Interface, namespace, name string)
 	return pod.Annotations[util.OvnPodAnnotationName]
 }
 
+// getRetryPod returns the entry of a pod waiting to be retried, if any
+func getRetryPod(oc *Controller, uid types.UID) (retryEntry, bool) {
+	oc.retryPodsLock.Lock()
+	defer oc.retryPodsLock.Unlock()
+	podEntry, ok := oc.retryPods[uid]
+	return podEntry, ok
+}
+
+// setRetryPodBackoff restarts the wait of a pod waiting to be retried with
+// the given backoff; a zero timeStamp makes the pod due for the next retry
+func setRetryPodBackoff(oc *Controller, uid types.UID, timeStamp time.Time, backoff time.Duration) {
+	oc.retryPodsLock.Lock()
+	defer oc.retryPodsLock.Unlock()
+	podEntry, ok := oc.retryPods[uid]
+	gomega.Expect(ok).To(gomega.BeTrue())
+	podEntry.timeStamp = timeStamp
+	podEntry.backoff = backoff
+	oc.retryPods[uid] = podEntry
+}
+
 func newPodMeta(namespace, name string, additionalLabels map[string]string) metav1.ObjectMeta {
 	labels := map[string]string{
 		"name": name,
@@ -399,6 +419,153 @@ var _ = ginkgo.Describe("OVN Pod Operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("keeps the retry backoff of a failed pod when its Update fails too", func() {
+			app.Action = func(ctx *cli.Context) error {
+
+				namespaceT := *newNamespace("namespace1")
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+
+				fakeOvn.start(ctx,
+					&v1.NamespaceList{
+						Items: []v1.Namespace{
+							namespaceT,
+						},
+					},
+					&v1.PodList{
+						Items: []v1.Pod{
+							*newPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+				)
+				t.populateLogicalSwitchCache(fakeOvn)
+				mockAddNBDBError(ovntest.LogicalSwitchPortType, t.portName,
+					ovntest.LogicalSwitchPortExternalId,
+					fmt.Errorf("injected dummy port external_ids set error"),
+					fakeOvn.ovnNBClient)
+				fakeOvn.controller.WatchNamespaces()
+				fakeOvn.controller.WatchPods()
+				gomega.Expect(fExec.CalledMatchesExpected()).To(gomega.BeTrue(), fExec.ErrorDesc)
+				uid := types.UID(t.podName)
+
+				// the pod has already failed a few retries
+				gomega.Eventually(func() bool {
+					_, ok := getRetryPod(fakeOvn.controller, uid)
+					return ok
+				}, 2).Should(gomega.BeTrue())
+				setRetryPodBackoff(fakeOvn.controller, uid, time.Now(), 8*time.Second)
+
+				patch := struct {
+					Metadata map[string]interface{} `json:"metadata"`
+				}{
+					Metadata: map[string]interface{}{
+						"annotations": map[string]string{"dummy": "data"},
+					},
+				}
+				patchData, err := json.Marshal(&patch)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				// trigger update event, which fails as well
+				_, err = fakeOvn.fakeClient.KubeClient.CoreV1().Pods(t.namespace).Patch(context.TODO(), t.podName, types.MergePatchType, patchData, metav1.PatchOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(func() string {
+					podEntry, _ := getRetryPod(fakeOvn.controller, uid)
+					if podEntry.pod == nil {
+						return ""
+					}
+					return podEntry.pod.Annotations["dummy"]
+				}, 2).Should(gomega.Equal("data"))
+				podEntry, _ := getRetryPod(fakeOvn.controller, uid)
+				gomega.Expect(podEntry.backoff).To(gomega.Equal(8 * time.Second))
+				_, err = fakeOvn.controller.logicalPortCache.get(t.portName)
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("retries a failed pod Add without an Update", func() {
+			app.Action = func(ctx *cli.Context) error {
+
+				namespaceT := *newNamespace("namespace1")
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+
+				fakeOvn.start(ctx,
+					&v1.NamespaceList{
+						Items: []v1.Namespace{
+							namespaceT,
+						},
+					},
+					&v1.PodList{
+						Items: []v1.Pod{
+							*newPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+				)
+				t.populateLogicalSwitchCache(fakeOvn)
+				mockAddNBDBError(ovntest.LogicalSwitchPortType, t.portName,
+					ovntest.LogicalSwitchPortExternalId,
+					fmt.Errorf("injected dummy port external_ids set error"),
+					fakeOvn.ovnNBClient)
+				fakeOvn.controller.WatchNamespaces()
+				fakeOvn.controller.WatchPods()
+				gomega.Expect(fExec.CalledMatchesExpected()).To(gomega.BeTrue(), fExec.ErrorDesc)
+				uid := types.UID(t.podName)
+
+				// the initial add fails and the pod waits for the initial backoff
+				gomega.Eventually(func() bool {
+					_, ok := getRetryPod(fakeOvn.controller, uid)
+					return ok
+				}, 2).Should(gomega.BeTrue())
+				podEntry, _ := getRetryPod(fakeOvn.controller, uid)
+				gomega.Expect(podEntry.backoff).To(gomega.Equal(retryPodInitialBackoff))
+
+				// a retry that fails again doubles the backoff
+				setRetryPodBackoff(fakeOvn.controller, uid, time.Time{}, podEntry.backoff)
+				fakeOvn.controller.iterateRetryPods()
+				podEntry, ok := getRetryPod(fakeOvn.controller, uid)
+				gomega.Expect(ok).To(gomega.BeTrue())
+				gomega.Expect(podEntry.backoff).To(gomega.BeNumerically(">=", 2*time.Second))
+				gomega.Expect(podEntry.backoff).To(gomega.BeNumerically("<", 3*time.Second))
+				_, err := fakeOvn.controller.logicalPortCache.get(t.portName)
+				gomega.Expect(err).To(gomega.HaveOccurred())
+
+				// no update event is sent; the first retry after the backoff sets up the pod
+				mockDelNBDBError(ovntest.LogicalSwitchPortType, t.portName,
+					ovntest.LogicalSwitchPortExternalId,
+					fakeOvn.ovnNBClient)
+				setRetryPodBackoff(fakeOvn.controller, uid, time.Time{}, podEntry.backoff)
+				fakeOvn.controller.iterateRetryPods()
+				_, ok = getRetryPod(fakeOvn.controller, uid)
+				gomega.Expect(ok).To(gomega.BeFalse())
+				_, err = fakeOvn.controller.logicalPortCache.get(t.portName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(getPodAnnotations(fakeOvn.fakeClient.KubeClient, t.namespace, t.podName)).To(gomega.MatchJSON(`{"default": {"ip_addresses":["` + t.podIP + `/24"], "mac_address":"` + t.podMAC + `", "gateway_ips": ["` + t.nodeGWIP + `"], "ip_address":"` + t.podIP + `/24", "gateway_ip": "` + t.nodeGWIP + `"}}`))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("retries a failed pod Add when namespace doesn't yet exist", func() {
 			app.Action = func(ctx *cli.Context) error {
 